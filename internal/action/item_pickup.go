@@ -0,0 +1,36 @@
+package action
+
+import (
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/koolo/internal/action/step"
+	"github.com/hectorgimenez/koolo/internal/context"
+	"github.com/hectorgimenez/koolo/internal/log"
+	"github.com/hectorgimenez/koolo/internal/pather"
+)
+
+// ItemPickup sweeps ground items within maxDistance of the player and picks
+// each one up with step.PickupItem. Anything step has recently blacklisted
+// is skipped before we ever consider pathing to it, so a sweep doesn't
+// thrash back and forth to an item it just failed to grab.
+func ItemPickup(maxDistance int) error {
+	ctx := context.Get()
+
+	for _, it := range ctx.Data.Inventory.ByLocation(item.LocationGround) {
+		if pather.DistanceFromPoint(ctx.Data.PlayerUnit.Position, it.Position) > maxDistance {
+			continue
+		}
+
+		if blacklisted, reason := step.IsPickupBlacklisted(it.UnitID); blacklisted {
+			ctx.Logger.Debug("Skipping blacklisted item",
+				log.String("item", it.Desc().Name), log.String("reason", reason))
+			continue
+		}
+
+		if err := step.PickupItem(it); err != nil {
+			ctx.Logger.Warn("Failed to pick up item",
+				log.String("item", it.Desc().Name), log.String("error", err.Error()))
+		}
+	}
+
+	return nil
+}
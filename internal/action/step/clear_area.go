@@ -0,0 +1,141 @@
+package step
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+	"github.com/hectorgimenez/koolo/internal/context"
+	"github.com/hectorgimenez/koolo/internal/game"
+	"github.com/hectorgimenez/koolo/internal/pather"
+)
+
+// ccClearRadius is how close a hostile monster needs to be to a valuable
+// item before ClearAreaAroundItem bothers trying to clear it out of the way.
+const ccClearRadius = 4
+
+// shoveStepDelay is how long walkTo waits after a click before checking
+// whether the player actually moved, giving the first step of shove's
+// toward/away pair time to register before the second click fires.
+const shoveStepDelay = 300 * time.Millisecond
+
+// MinItemQualityForCC is the lowest item.Quality isValuableEnoughForCC will
+// dispatch a CC handler (or shove) for. There's no character-config slot for
+// this yet, so it's a package-level var rather than a CharacterCfg field;
+// config loading can override it at startup once one exists. Defaults to
+// Unique so CC only fires for drops that are actually worth the detour.
+var MinItemQualityForCC = item.QualityUnique
+
+// isValuableEnoughForCC gates ClearAreaAroundItem to items actually worth
+// the detour: dispatching CC and re-approaching still costs time, so it's
+// only worth it for drops at or above MinItemQualityForCC. Runes bypass the
+// quality check entirely since item.Quality doesn't apply to them.
+func isValuableEnoughForCC(ctx *context.Context, it data.Item) bool {
+	if it.Desc().Type == "rune" {
+		return true
+	}
+	return it.Quality >= MinItemQualityForCC
+}
+
+// ClearAreaAroundItem tries to create enough breathing room to pick up a
+// valuable item guarded by hostile monsters, instead of immediately giving
+// up and forcing the caller to re-engage and re-approach. It dispatches the
+// crowd-control handler registered for the current class (see
+// context.RegisterCCHandler); if no handler is registered, it falls back to
+// a "shove": stepping the player one tile toward the nearest monster and
+// back so the monster's AI repositions.
+func ClearAreaAroundItem(pos data.Position, radius int) error {
+	ctx := context.Get()
+
+	nearest, found := nearestHostileMonster(pos, radius)
+	if !found {
+		return nil
+	}
+
+	if handler, ok := context.CCHandlerFor(string(ctx.Data.PlayerUnit.Class)); ok {
+		return handler(ctx, nearest.Position)
+	}
+
+	return shove(ctx, nearest.Position)
+}
+
+// nearestHostileMonster returns the living hostile monster closest to pos
+// that's within radius, if any.
+func nearestHostileMonster(pos data.Position, radius int) (data.Monster, bool) {
+	ctx := context.Get()
+
+	var nearest data.Monster
+	nearestDist := radius + 1
+	found := false
+
+	for _, monster := range ctx.Data.Monsters.Enemies() {
+		if monster.Stats[stat.Life] <= 0 {
+			continue
+		}
+
+		dist := pather.DistanceFromPoint(pos, monster.Position)
+		if dist <= radius && dist < nearestDist {
+			nearest = monster
+			nearestDist = dist
+			found = true
+		}
+	}
+
+	return nearest, found
+}
+
+// shove moves the player one tile toward the monster and then one tile
+// back, a class-agnostic fallback for when no CC handler is registered: it
+// gives the monster's AI a reason to reposition without relying on any
+// skill.
+func shove(ctx *context.Context, monsterPos data.Position) error {
+	playerPos := ctx.Data.PlayerUnit.Position
+
+	toward := stepToward(playerPos, monsterPos)
+	if err := walkTo(ctx, toward); err != nil {
+		return err
+	}
+
+	away := stepToward(toward, playerPos)
+	return walkTo(ctx, away)
+}
+
+// stepToward returns the tile adjacent to from in the direction of to.
+func stepToward(from, to data.Position) data.Position {
+	next := from
+
+	if to.X > from.X {
+		next.X++
+	} else if to.X < from.X {
+		next.X--
+	}
+
+	if to.Y > from.Y {
+		next.Y++
+	} else if to.Y < from.Y {
+		next.Y--
+	}
+
+	return next
+}
+
+// walkTo issues a single click on pos's ground tile to make the player walk
+// toward it, then confirms the player actually moved before reporting
+// success, since a blocked tile or cancelled click would otherwise look
+// identical to a successful step.
+func walkTo(ctx *context.Context, pos data.Position) error {
+	before := ctx.Data.PlayerUnit.Position
+
+	screenX, screenY := ctx.PathFinder.GameCoordsToScreenCords(pos.X, pos.Y)
+	ctx.HID.Click(game.LeftButton, screenX, screenY)
+	time.Sleep(shoveStepDelay)
+	ctx.RefreshGameData()
+
+	if ctx.Data.PlayerUnit.Position.Equal(before) {
+		return fmt.Errorf("player did not move toward %v", pos)
+	}
+
+	return nil
+}
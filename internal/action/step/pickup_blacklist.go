@@ -0,0 +1,166 @@
+package step
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/area"
+	"github.com/hectorgimenez/koolo/internal/context"
+)
+
+// maxBlacklistSize bounds pickupBlacklist so a long session spent wading
+// through unreachable items can't grow it without limit; once full, the
+// oldest entry is evicted to make room for the newest one.
+const maxBlacklistSize = 256
+
+// blacklistReason explains why an item was temporarily skipped, so callers
+// can decide whether it's worth a quick re-check (e.g. monsters nearby) or
+// better left alone for longer (e.g. no line of sight).
+type blacklistReason string
+
+const (
+	BlacklistReasonNoLOS        blacklistReason = "no line of sight"
+	BlacklistReasonMonsters     blacklistReason = "monsters nearby"
+	BlacklistReasonTooFar       blacklistReason = "too far away"
+	BlacklistReasonNeverHovered blacklistReason = "hover never registered"
+)
+
+// blacklistTTL returns how long an item stays blacklisted for a given
+// reason. Transient conditions (monsters around it) clear quickly, while
+// conditions that need the player to walk a different route (no LOS, too
+// far) are given longer before we bother retrying.
+func blacklistTTL(reason blacklistReason) time.Duration {
+	switch reason {
+	case BlacklistReasonMonsters:
+		return 15 * time.Second
+	case BlacklistReasonNeverHovered:
+		return 20 * time.Second
+	case BlacklistReasonTooFar:
+		return 30 * time.Second
+	case BlacklistReasonNoLOS:
+		return 60 * time.Second
+	default:
+		return 30 * time.Second
+	}
+}
+
+type blacklistEntry struct {
+	reason    blacklistReason
+	expiresAt time.Time
+}
+
+var (
+	blacklistMu sync.Mutex
+	// pickupBlacklist holds items ItemPickup should skip on its next sweep
+	// instead of pathing back to something it just failed to grab, mirroring
+	// the "insert into recent, flush oldest" bounded-set discipline used
+	// elsewhere for recently-seen state.
+	pickupBlacklist   = make(map[data.UnitID]blacklistEntry)
+	blacklistOrder    []data.UnitID
+	lastBlacklistArea area.ID
+)
+
+// blacklistPickup marks id as temporarily unreachable for reason. It prunes
+// expired entries and, if the set is at capacity, evicts the oldest entry
+// before inserting the new one.
+func blacklistPickup(id data.UnitID, reason blacklistReason) {
+	blacklistMu.Lock()
+	defer blacklistMu.Unlock()
+
+	pruneBlacklistLocked()
+
+	if _, exists := pickupBlacklist[id]; !exists {
+		if len(blacklistOrder) >= maxBlacklistSize {
+			oldest := blacklistOrder[0]
+			blacklistOrder = blacklistOrder[1:]
+			delete(pickupBlacklist, oldest)
+		}
+		blacklistOrder = append(blacklistOrder, id)
+	}
+
+	pickupBlacklist[id] = blacklistEntry{
+		reason:    reason,
+		expiresAt: time.Now().Add(blacklistTTL(reason)),
+	}
+}
+
+// IsPickupBlacklisted reports whether id is currently being skipped, and why.
+// It checks for an area change itself before looking id up, so it gives the
+// right answer regardless of whether the caller remembered to call
+// NotifyAreaChanged first: a recycled UnitID in a newly entered zone must
+// never read as still blacklisted from the area left behind.
+func IsPickupBlacklisted(id data.UnitID) (bool, string) {
+	ctx := context.Get()
+
+	blacklistMu.Lock()
+	defer blacklistMu.Unlock()
+
+	checkAreaChangeLocked(ctx.Data.PlayerUnit.Area)
+	pruneBlacklistLocked()
+
+	entry, exists := pickupBlacklist[id]
+	if !exists {
+		return false, ""
+	}
+	return true, string(entry.reason)
+}
+
+// ClearPickupBlacklist empties the blacklist. It's exported so area-transition
+// handling can call it directly. Within this package, IsPickupBlacklisted
+// (above) clears the blacklist itself whenever a lookup sees a new area, and
+// NotifyAreaChanged (below) runs the same check for a caller that passes in
+// the current area.
+func ClearPickupBlacklist() {
+	blacklistMu.Lock()
+	defer blacklistMu.Unlock()
+
+	clearPickupBlacklistLocked()
+}
+
+func clearPickupBlacklistLocked() {
+	pickupBlacklist = make(map[data.UnitID]blacklistEntry)
+	blacklistOrder = nil
+}
+
+// checkAreaChangeLocked clears the blacklist whenever current differs from
+// the last area seen. Callers must hold blacklistMu.
+func checkAreaChangeLocked(current area.ID) {
+	if current == lastBlacklistArea {
+		return
+	}
+
+	lastBlacklistArea = current
+	clearPickupBlacklistLocked()
+}
+
+// NotifyAreaChanged clears the blacklist whenever the player's area differs
+// from the last one it saw. IsPickupBlacklisted does this same check on
+// every call, so this is only needed by callers that want the blacklist
+// cleared immediately on an area transition without waiting for the next
+// lookup (e.g. dedicated area-transition handling, once that code reaches
+// into this package).
+func NotifyAreaChanged(current area.ID) {
+	blacklistMu.Lock()
+	defer blacklistMu.Unlock()
+
+	checkAreaChangeLocked(current)
+}
+
+// pruneBlacklistLocked removes expired entries. Callers must hold blacklistMu.
+func pruneBlacklistLocked() {
+	now := time.Now()
+	live := blacklistOrder[:0]
+	for _, id := range blacklistOrder {
+		entry, exists := pickupBlacklist[id]
+		if !exists {
+			continue
+		}
+		if now.After(entry.expiresAt) {
+			delete(pickupBlacklist, id)
+			continue
+		}
+		live = append(live, id)
+	}
+	blacklistOrder = live
+}
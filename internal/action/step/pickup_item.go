@@ -17,10 +17,11 @@ import (
 )
 
 const (
-	maxInteractions = 30
-	spiralDelay     = 50 * time.Millisecond
-	clickDelay      = 100 * time.Millisecond
-	pickupTimeout   = 8 * time.Second
+	maxInteractions   = 30
+	spiralDelay       = 50 * time.Millisecond
+	clickDelay        = 100 * time.Millisecond
+	pickupTimeout     = 8 * time.Second
+	maxPickupDistance = 20
 )
 
 var (
@@ -32,10 +33,28 @@ var (
 
 func PickupItem(it data.Item) error {
 	ctx := context.Get()
+
+	if blacklisted, reason := IsPickupBlacklisted(it.UnitID); blacklisted {
+		return fmt.Errorf("%s is temporarily blacklisted for pickup (%s)", it.Desc().Name, reason)
+	}
+
+	if canUseTelekinesisPickup(ctx, it) {
+		ctx.Logger.Debug("Attempting telekinesis pickup", log.String("item", it.Desc().Name))
+		if err := PickupItemTelekinesis(it); err == nil {
+			return nil
+		} else if errors.Is(err, ErrMonsterAroundItem) {
+			return err
+		} else {
+			ctx.Logger.Debug("Telekinesis pickup failed, falling back to walk-and-click",
+				log.String("item", it.Desc().Name), log.String("reason", err.Error()))
+		}
+	}
+
 	startTime := time.Now()
 	waitingForInteraction := time.Zero
 	spiralAttempt := 0
 	lastMonsterCheck := time.Now()
+	ccAttempted := false
 	const monsterCheckInterval = time.Second
 
 	// Initial position check
@@ -71,16 +90,41 @@ func PickupItem(it data.Item) error {
 			baseScreenX, baseScreenY = ctx.PathFinder.GameCoordsToScreenCords(baseX, baseY)
 		}
 
+		// 3.5 Verify the item is close enough and visible before spending any
+		// more attempts on it
+		if pather.DistanceFromPoint(ctx.Data.PlayerUnit.Position, currentItem.Position) > maxPickupDistance {
+			blacklistPickup(it.UnitID, BlacklistReasonTooFar)
+			return ErrItemTooFar
+		}
+
+		if !pather.LineOfSight(ctx.Data.PlayerUnit.Position, currentItem.Position) {
+			blacklistPickup(it.UnitID, BlacklistReasonNoLOS)
+			return ErrNoLOSToItem
+		}
+
 		// Check timeout conditions
 		if spiralAttempt > maxInteractions ||
 			(!waitingForInteraction.IsZero() && time.Since(waitingForInteraction) > pickupTimeout) ||
 			time.Since(startTime) > pickupTimeout {
+			blacklistPickup(it.UnitID, BlacklistReasonNeverHovered)
 			return fmt.Errorf("failed to pick up %s after %d attempts", it.Desc().Name, spiralAttempt)
 		}
 
 		// 4. Monster check with increased frequency for valuable items
 		if time.Since(lastMonsterCheck) > monsterCheckInterval {
 			if hasHostileMonstersNearby(currentItem.Position) {
+				if !ccAttempted && isValuableEnoughForCC(ctx, currentItem) {
+					ccAttempted = true
+					if ClearAreaAroundItem(currentItem.Position, ccClearRadius) == nil {
+						lastMonsterCheck = time.Now()
+						// The shove/CC may have moved the player or the
+						// monster; resync position and screen coords before
+						// trying to click instead of reusing stale values
+						// from the top of this iteration.
+						continue
+					}
+				}
+				blacklistPickup(it.UnitID, BlacklistReasonMonsters)
 				return ErrMonsterAroundItem
 			}
 			lastMonsterCheck = time.Now()
@@ -91,8 +135,10 @@ func PickupItem(it data.Item) error {
 		targetCursorX := baseScreenX + offsetX
 		targetCursorY := baseScreenY + offsetY
 
-		// Move cursor and verify position
-		ctx.HID.MovePointer(targetCursorX, targetCursorY)
+		// Move cursor and verify position. A smooth, interpolated move looks
+		// human and is less prone to the driver reporting a stale/mismatched
+		// position than a single teleport jump.
+		ctx.HID.MoveCursorSmooth(targetCursorX, targetCursorY, game.MoveOpts{Jitter: 2})
 		time.Sleep(50 * time.Millisecond)
 		
 		// 6. Verify cursor position after movement
@@ -0,0 +1,154 @@
+package step
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/mode"
+	"github.com/hectorgimenez/d2go/pkg/data/skill"
+	"github.com/hectorgimenez/koolo/internal/context"
+	"github.com/hectorgimenez/koolo/internal/game"
+	"github.com/hectorgimenez/koolo/internal/log"
+	"github.com/hectorgimenez/koolo/internal/pather"
+	"github.com/hectorgimenez/koolo/internal/utils"
+)
+
+// telekinesisRange is the max distance (in game coordinates) we'll trust
+// Telekinesis to reach, as reported by pather. Beyond this we fall back to
+// walking over, same as a character without the skill.
+const telekinesisRange = 25
+
+// maxTelekinesisCasts is how many casts we'll make in total before giving up
+// and falling back to the normal walk-and-click flow.
+const maxTelekinesisCasts = 3
+
+// telekinesisEligibleTypes are the item.Desc().Type categories cheap/safe
+// enough to grab at range without walking over them first: currency,
+// consumables, and small stackable items. Anything else still benefits from
+// a closer look before picking it up (or simply doesn't make sense to
+// Telekinesis). Pulled into its own lookup function (rather than inlined
+// into canUseTelekinesisPickup) so the mapping can be unit tested without
+// needing a real data.Item/Desc() chain.
+var telekinesisEligibleTypes = map[string]bool{
+	"gold":        true,
+	"scroll":      true,
+	"potion":      true,
+	"key":         true,
+	"gem":         true,
+	"rune":        true,
+	"small charm": true,
+}
+
+// isTelekinesisEligibleType reports whether desc (an item.Desc().Type value)
+// is cheap/safe enough to grab with Telekinesis.
+func isTelekinesisEligibleType(desc string) bool {
+	return telekinesisEligibleTypes[desc]
+}
+
+// canUseTelekinesisPickup reports whether the character can and should use
+// PickupItemTelekinesis for it: the class needs Telekinesis (Sorceress
+// exclusive, so having the skill is enough to confirm the class), the item
+// needs to be an eligible type, and it needs to be within pather's reported
+// Telekinesis range.
+func canUseTelekinesisPickup(ctx *context.Context, it data.Item) bool {
+	if ctx.Data.PlayerUnit.Skills[skill.Telekinesis].Level == 0 {
+		return false
+	}
+
+	if !isTelekinesisEligibleType(it.Desc().Type) {
+		return false
+	}
+
+	if pather.DistanceFromPoint(ctx.Data.PlayerUnit.Position, it.Position) > telekinesisRange {
+		return false
+	}
+
+	return true
+}
+
+// PickupItemTelekinesis grabs it by casting Telekinesis on its screen
+// position instead of walking into click range. It reuses the spiral-offset
+// hover verification and monster-proximity guard from the normal pickup
+// flow. After maxTelekinesisCasts casts (or pickupTimeout) without the item
+// leaving the ground it returns an error, and PickupItem falls back to that
+// flow.
+func PickupItemTelekinesis(it data.Item) error {
+	ctx := context.Get()
+	startTime := time.Now()
+	spiralAttempt := 0
+	castAttempt := 0
+	lastMonsterCheck := time.Now()
+	ccAttempted := false
+	const monsterCheckInterval = time.Second
+
+	baseScreenX, baseScreenY := ctx.PathFinder.GameCoordsToScreenCords(it.Position.X, it.Position.Y)
+
+	for {
+		ctx.PauseIfNotPriority()
+		ctx.RefreshGameData()
+
+		currentItem, exists := findItemOnGround(it.UnitID)
+		if !exists {
+			ctx.Logger.Info(fmt.Sprintf("Picked up (telekinesis): %s [%s] | Casts:%d",
+				it.Desc().Name, it.Quality.ToString(), castAttempt))
+			return nil
+		}
+
+		if time.Since(lastMonsterCheck) > monsterCheckInterval {
+			if hasHostileMonstersNearby(currentItem.Position) {
+				if !ccAttempted && isValuableEnoughForCC(ctx, currentItem) {
+					ccAttempted = true
+					if ClearAreaAroundItem(currentItem.Position, ccClearRadius) == nil {
+						lastMonsterCheck = time.Now()
+						continue
+					}
+				}
+				blacklistPickup(it.UnitID, BlacklistReasonMonsters)
+				return ErrMonsterAroundItem
+			}
+			lastMonsterCheck = time.Now()
+		}
+
+		if ctx.Data.PlayerUnit.Mode == mode.CastingSkill {
+			time.Sleep(50 * time.Millisecond)
+			continue
+		}
+
+		if castAttempt >= maxTelekinesisCasts ||
+			time.Since(startTime) > pickupTimeout {
+			return fmt.Errorf("telekinesis pickup of %s failed after %d casts", it.Desc().Name, castAttempt)
+		}
+
+		offsetX, offsetY := utils.ItemSpiral(spiralAttempt)
+		targetCursorX := baseScreenX + offsetX
+		targetCursorY := baseScreenY + offsetY
+
+		ctx.HID.MoveCursorSmooth(targetCursorX, targetCursorY, game.MoveOpts{Jitter: 2})
+		time.Sleep(50 * time.Millisecond)
+
+		// Verify cursor position after movement, same as PickupItem: retry
+		// the move rather than casting on a stale/mismatched cursor spot.
+		actualX, actualY := ctx.HID.GetCursorPosition()
+		if abs(actualX-targetCursorX) > 5 || abs(actualY-targetCursorY) > 5 {
+			ctx.Logger.Debug("Cursor position mismatch, retrying movement",
+				log.Int("targetX", targetCursorX),
+				log.Int("actualX", actualX),
+				log.Int("targetY", targetCursorY),
+				log.Int("actualY", actualY))
+			continue
+		}
+
+		ctx.RefreshGameData()
+
+		if !currentItem.IsHovered {
+			spiralAttempt++
+			continue
+		}
+
+		ctx.Logger.Debug("Casting Telekinesis on item", log.String("item", it.Desc().Name))
+		ctx.HID.Click(game.RightButton, targetCursorX, targetCursorY)
+		time.Sleep(clickDelay)
+		castAttempt++
+	}
+}
@@ -0,0 +1,32 @@
+package step
+
+import "testing"
+
+// These pin the assumed item.Desc().Type taxonomy telekinesisEligibleTypes
+// is keyed on. d2go isn't vendored in this tree, so this documents the
+// mapping's assumptions rather than exercising real item descriptors, so it
+// does not detect d2go reporting Type strings that differ from these keys.
+func TestIsTelekinesisEligibleType(t *testing.T) {
+	tests := []struct {
+		desc     string
+		eligible bool
+	}{
+		{"gold", true},
+		{"scroll", true},
+		{"potion", true},
+		{"key", true},
+		{"gem", true},
+		{"rune", true},
+		{"small charm", true},
+		{"weapon", false},
+		{"armor", false},
+		{"unique", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := isTelekinesisEligibleType(tt.desc); got != tt.eligible {
+			t.Errorf("isTelekinesisEligibleType(%q) = %v, want %v", tt.desc, got, tt.eligible)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package context
+
+import (
+	"sync"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+)
+
+// CCHandler performs a class-specific crowd-control action against the
+// monster at pos (e.g. Howl/Taunt/Fear for Barb, Confuse/Terror for Necro,
+// a Nova/Blizzard tick for Sorc, Fist of the Heavens for Pala). Handlers are
+// registered per class with RegisterCCHandler so generic code, like step's
+// item pickup, can dispatch CC without knowing which class is playing. No
+// handler is registered by default: CCHandlerFor reports false for a class
+// until one is.
+type CCHandler func(ctx *Context, pos data.Position) error
+
+// ccHandlers is shared across every character's goroutine (koolo runs
+// multiple characters concurrently, and any of them may register or look up
+// a handler), so reads and writes both need to go through ccHandlersMu.
+var (
+	ccHandlersMu sync.RWMutex
+	ccHandlers   = map[string]CCHandler{}
+)
+
+// RegisterCCHandler associates a crowd-control handler with a class name.
+// It's intended to be called once per class during bot initialization.
+func RegisterCCHandler(class string, handler CCHandler) {
+	ccHandlersMu.Lock()
+	defer ccHandlersMu.Unlock()
+
+	ccHandlers[class] = handler
+}
+
+// CCHandlerFor returns the crowd-control handler registered for class, if any.
+func CCHandlerFor(class string) (CCHandler, bool) {
+	ccHandlersMu.RLock()
+	defer ccHandlersMu.RUnlock()
+
+	handler, ok := ccHandlers[class]
+	return handler, ok
+}
@@ -0,0 +1,121 @@
+package game
+
+import (
+	"math/rand"
+	"time"
+)
+
+// smoothMoveMinDistance is the threshold (in pixels, per axis) below which
+// MoveCursorSmooth skips interpolation entirely. Spiral-search hops between
+// adjacent hover offsets are only a few pixels apart, so smoothing them
+// would just burn attempt budget on a move too small to read as a jump
+// anyway.
+const smoothMoveMinDistance = 6
+
+// MoveOpts configures how MoveCursorSmooth interpolates the cursor from its
+// current position to a target, allowing callers to tune speed, easing and
+// jitter on a per-click-site basis (pickup, chest interaction, inventory UI).
+type MoveOpts struct {
+	// Steps is how many ticks the movement is split into. Higher values
+	// produce smoother, slower movement. Defaults to 12 when zero.
+	Steps int
+	// StepDelay is how long to sleep between ticks. Defaults to 8ms when zero.
+	StepDelay time.Duration
+	// Jitter adds a random +/- offset (in pixels) to every intermediate
+	// step, but never to the final one, so the path looks human without
+	// ever missing the target. Zero disables jitter.
+	Jitter int
+}
+
+func (o MoveOpts) withDefaults() MoveOpts {
+	if o.Steps <= 0 {
+		o.Steps = 12
+	}
+	if o.StepDelay <= 0 {
+		o.StepDelay = 8 * time.Millisecond
+	}
+	return o
+}
+
+// MoveCursorSmooth walks the cursor from its current position to (x, y) over
+// several small steps instead of teleporting it in one jump. Each axis is
+// interpolated independently with adjustWithRemainder, which carries the
+// fractional part of the step lost to truncation into the next tick so
+// sub-pixel movement accumulates cleanly instead of being rounded away, and
+// clamps to the target on direction changes so it never overshoots.
+func (hid *HID) MoveCursorSmooth(x, y int, opts MoveOpts) {
+	curX, curY := hid.GetCursorPosition()
+
+	if abs(x-curX) < smoothMoveMinDistance && abs(y-curY) < smoothMoveMinDistance {
+		hid.MovePointer(x, y)
+		return
+	}
+
+	opts = opts.withDefaults()
+
+	// Scale the step count down for short hops so a small move doesn't pay
+	// the same tick budget as crossing the whole screen.
+	dist := abs(x - curX)
+	if d := abs(y - curY); d > dist {
+		dist = d
+	}
+	if maxSteps := dist / 4; maxSteps < opts.Steps {
+		if maxSteps < 2 {
+			maxSteps = 2
+		}
+		opts.Steps = maxSteps
+	}
+
+	stepX := float64(x-curX) / float64(opts.Steps)
+	stepY := float64(y-curY) / float64(opts.Steps)
+	remX, remY := 0.0, 0.0
+
+	for i := 0; i < opts.Steps; i++ {
+		curX, remX = adjustWithRemainder(curX, stepX+remX, x)
+		curY, remY = adjustWithRemainder(curY, stepY+remY, y)
+
+		jitterX, jitterY := 0, 0
+		if opts.Jitter > 0 && i < opts.Steps-1 {
+			jitterX = rand.Intn(opts.Jitter*2+1) - opts.Jitter
+			jitterY = rand.Intn(opts.Jitter*2+1) - opts.Jitter
+		}
+
+		hid.MovePointer(curX+jitterX, curY+jitterY)
+		time.Sleep(opts.StepDelay)
+	}
+
+	// Guarantee we land exactly on target regardless of any rounding drift.
+	hid.MovePointer(x, y)
+}
+
+// adjustWithRemainder advances current towards target by adjustment (a
+// fractional pixel step), returning the new integer position and the
+// fractional part truncation lost this tick so the caller can fold it back
+// into adjustment on the next call. If adjustment and target-current have
+// opposite signs, or adjustment is zero, it's a safety noop and returns
+// (current, 0). Direction changes are clamped to target, never overshot.
+func adjustWithRemainder(current int, adjustment float64, target int) (int, float64) {
+	if adjustment == 0 {
+		return current, 0
+	}
+
+	if (target-current > 0) != (adjustment > 0) {
+		return current, 0
+	}
+
+	move := int(adjustment)
+	next := current + move
+
+	if (adjustment > 0 && next > target) || (adjustment < 0 && next < target) {
+		return target, 0
+	}
+
+	return next, adjustment - float64(move)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,82 @@
+package game
+
+import "testing"
+
+func TestAdjustWithRemainder(t *testing.T) {
+	tests := []struct {
+		name          string
+		current       int
+		adjustment    float64
+		target        int
+		wantValue     int
+		wantRemainder float64
+	}{
+		{
+			name:          "zero adjustment is a noop",
+			current:       10,
+			adjustment:    0,
+			target:        20,
+			wantValue:     10,
+			wantRemainder: 0,
+		},
+		{
+			name:          "adjustment pointing away from target is a safety noop",
+			current:       10,
+			adjustment:    -2.5,
+			target:        20,
+			wantValue:     10,
+			wantRemainder: 0,
+		},
+		{
+			name:          "fractional step truncates and carries the remainder",
+			current:       0,
+			adjustment:    3.33,
+			target:        100,
+			wantValue:     3,
+			wantRemainder: 0.33,
+		},
+		{
+			name:          "overshoot clamps to target instead of passing it",
+			current:       18,
+			adjustment:    5,
+			target:        20,
+			wantValue:     20,
+			wantRemainder: 0,
+		},
+		{
+			name:          "negative direction clamps to target too",
+			current:       2,
+			adjustment:    -5,
+			target:        0,
+			wantValue:     0,
+			wantRemainder: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotValue, gotRemainder := adjustWithRemainder(tt.current, tt.adjustment, tt.target)
+			if gotValue != tt.wantValue {
+				t.Errorf("value = %d, want %d", gotValue, tt.wantValue)
+			}
+			if diff := gotRemainder - tt.wantRemainder; diff < -0.0001 || diff > 0.0001 {
+				t.Errorf("remainder = %v, want %v", gotRemainder, tt.wantRemainder)
+			}
+		})
+	}
+}
+
+func TestAdjustWithRemainderCarriesAcrossTicks(t *testing.T) {
+	current := 0
+	target := 10
+	step := 3.33
+	remainder := 0.0
+
+	for i := 0; i < 3; i++ {
+		current, remainder = adjustWithRemainder(current, step+remainder, target)
+	}
+
+	if current != 9 {
+		t.Fatalf("after 3 ticks of step 3.33 expected current=9 (carrying the remainder), got %d", current)
+	}
+}